@@ -0,0 +1,87 @@
+// Public domain.
+
+package coord
+
+import (
+	"math"
+
+	"github.com/soniakeys/unit"
+)
+
+// ConeBounds returns a conservative RA/Dec bounding box for a small
+// circle of angular radius around e, for use as a cheap pre-filter
+// ahead of an exact InCone test.
+//
+// When the circle crosses a celestial pole (|δ| + radius ≥ π/2), the
+// full RA range is returned.
+func (e *Equa) ConeBounds(radius unit.Angle) (raMin, raMax unit.RA, decMin, decMax unit.Angle) {
+	const halfPi = unit.Angle(math.Pi / 2)
+	dec := e.Dec
+	decMin, decMax = dec-radius, dec+radius
+	if decMin < -halfPi {
+		decMin = -halfPi
+	}
+	if decMax > halfPi {
+		decMax = halfPi
+	}
+	if math.Abs(float64(dec))+float64(radius) >= math.Pi/2 {
+		return unit.RA(0), unit.RA(twoPi), decMin, decMax
+	}
+	_, cDec := dec.Sincos()
+	sr, _ := radius.Sincos()
+	sinDRA := sr / cDec
+	if sinDRA > 1 {
+		sinDRA = 1
+	} else if sinDRA < -1 {
+		sinDRA = -1
+	}
+	dra := math.Asin(sinDRA)
+	ra := float64(e.RA.Angle())
+	raMin = unit.RAFromRad(ra - dra)
+	raMax = unit.RAFromRad(ra + dra)
+	return
+}
+
+// InCone reports whether e lies within radius of center, testing the
+// dot product of unit vectors directly rather than computing the exact
+// separation with Separation's atan2 formula.
+func (e *Equa) InCone(center *Equa, radius unit.Angle) bool {
+	var s Sphr
+	var a, b Cart
+	s.Lon, s.Lat = center.RA.Angle(), center.Dec
+	a.FromSphr(&s)
+	s.Lon, s.Lat = e.RA.Angle(), e.Dec
+	b.FromSphr(&s)
+	_, cr := radius.Sincos()
+	return a.Dot(&b) >= cr
+}
+
+// raInRange reports whether ra lies in [min, max], accounting for the
+// range wrapping through zero.
+func raInRange(ra, min, max unit.RA) bool {
+	if min <= max {
+		return ra >= min && ra <= max
+	}
+	return ra >= min || ra <= max
+}
+
+// CullCone appends to out the indices of members of e that lie within
+// radius of center, and returns the extended slice.
+//
+// It uses ConeBounds as a cheap pre-filter and InCone as the exact test,
+// the classic two-stage spatial filter used in catalog cross-matching.
+func (e EquaS) CullCone(center *Equa, radius unit.Angle, out []int) []int {
+	raMin, raMax, decMin, decMax := center.ConeBounds(radius)
+	for i := range e {
+		if e[i].Dec < decMin || e[i].Dec > decMax {
+			continue
+		}
+		if !raInRange(e[i].RA, raMin, raMax) {
+			continue
+		}
+		if e[i].InCone(center, radius) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
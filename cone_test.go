@@ -0,0 +1,54 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/soniakeys/coord"
+)
+
+func ExampleEqua_ConeBounds() {
+	center := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	raMin, raMax, decMin, decMax := center.ConeBounds(10 * math.Pi / 180)
+	fmt.Printf("%.1f %.1f %.1f %.1f\n",
+		float64(raMin.Angle())*180/math.Pi,
+		float64(raMax.Angle())*180/math.Pi,
+		float64(decMin)*180/math.Pi,
+		float64(decMax)*180/math.Pi)
+	// Output:
+	// 80.0 100.0 -10.0 10.0
+}
+
+func ExampleEqua_ConeBounds_pole() {
+	center := &coord.Equa{RA: 45 * math.Pi / 180, Dec: 85 * math.Pi / 180}
+	_, _, decMin, decMax := center.ConeBounds(10 * math.Pi / 180)
+	fmt.Printf("%.1f %.1f\n",
+		float64(decMin)*180/math.Pi, float64(decMax)*180/math.Pi)
+	// Output:
+	// 75.0 90.0
+}
+
+func ExampleEqua_InCone() {
+	center := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	p1 := &coord.Equa{RA: 95 * math.Pi / 180, Dec: 3 * math.Pi / 180}
+	p2 := &coord.Equa{RA: 150 * math.Pi / 180, Dec: 0}
+	fmt.Println(p1.InCone(center, 10*math.Pi/180))
+	fmt.Println(p2.InCone(center, 10*math.Pi/180))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleEquaS_CullCone() {
+	center := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	e := coord.EquaS{
+		{RA: 95 * math.Pi / 180, Dec: 3 * math.Pi / 180},
+		{RA: 150 * math.Pi / 180, Dec: 0},
+		{RA: 88 * math.Pi / 180, Dec: -2 * math.Pi / 180},
+	}
+	fmt.Println(e.CullCone(center, 10*math.Pi/180, nil))
+	// Output:
+	// [0 2]
+}
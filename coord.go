@@ -7,6 +7,7 @@
 package coord
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/unit"
@@ -63,6 +64,24 @@ func (z *Cart) RotateX(a *Cart, sin, cos float64) *Cart {
 	return z
 }
 
+// RotateY rotates the coordinate system around the Y axis using the sine
+// and cosine of a rotation angle.
+//
+// It sets z = a with coordinates rotated by sin, cos; returns z.
+func (z *Cart) RotateY(a *Cart, sin, cos float64) *Cart {
+	z.X, z.Y, z.Z = a.X*cos-a.Z*sin, a.Y, a.X*sin+a.Z*cos
+	return z
+}
+
+// RotateZ rotates the coordinate system around the Z axis using the sine
+// and cosine of a rotation angle.
+//
+// It sets z = a with coordinates rotated by sin, cos; returns z.
+func (z *Cart) RotateZ(a *Cart, sin, cos float64) *Cart {
+	z.X, z.Y, z.Z = a.X*cos+a.Y*sin, a.Y*cos-a.X*sin, a.Z
+	return z
+}
+
 // Dot returns the dot product of its argument and the receiver.
 func (a1 *Cart) Dot(a2 *Cart) float64 {
 	return a1.X*a2.X + a1.Y*a2.Y + a1.Z*a2.Z
@@ -235,3 +254,123 @@ func (z *M3) Transpose(a *M3) *M3 {
 	z[5], z[7], z[8] = a[7], a[5], a[8]
 	return z
 }
+
+// Mul does matrix multiplication.  It sets z = a × b, returns z.
+//
+// z may safely alias a or b.
+func (z *M3) Mul(a, b *M3) *M3 {
+	*z = M3{
+		a[0]*b[0] + a[1]*b[3] + a[2]*b[6],
+		a[0]*b[1] + a[1]*b[4] + a[2]*b[7],
+		a[0]*b[2] + a[1]*b[5] + a[2]*b[8],
+		a[3]*b[0] + a[4]*b[3] + a[5]*b[6],
+		a[3]*b[1] + a[4]*b[4] + a[5]*b[7],
+		a[3]*b[2] + a[4]*b[5] + a[5]*b[8],
+		a[6]*b[0] + a[7]*b[3] + a[8]*b[6],
+		a[6]*b[1] + a[7]*b[4] + a[8]*b[7],
+		a[6]*b[2] + a[7]*b[5] + a[8]*b[8],
+	}
+	return z
+}
+
+// Det returns the determinant of a.
+func (a *M3) Det() float64 {
+	return a[0]*(a[4]*a[8]-a[5]*a[7]) -
+		a[1]*(a[3]*a[8]-a[5]*a[6]) +
+		a[2]*(a[3]*a[7]-a[4]*a[6])
+}
+
+// ErrSingular is returned by M3.Inverse when the matrix has no inverse.
+var ErrSingular = errors.New("coord: matrix is singular")
+
+// Inverse sets z to the inverse of a, computed by the cofactor method,
+// and returns z.
+//
+// If a is singular, Inverse sets z to the identity matrix and returns
+// ErrSingular.
+func (z *M3) Inverse(a *M3) (*M3, error) {
+	det := a.Det()
+	if det == 0 {
+		*z = M3{1, 0, 0, 0, 1, 0, 0, 0, 1}
+		return z, ErrSingular
+	}
+	inv := 1 / det
+	*z = M3{
+		(a[4]*a[8] - a[5]*a[7]) * inv,
+		(a[2]*a[7] - a[1]*a[8]) * inv,
+		(a[1]*a[5] - a[2]*a[4]) * inv,
+		(a[5]*a[6] - a[3]*a[8]) * inv,
+		(a[0]*a[8] - a[2]*a[6]) * inv,
+		(a[2]*a[3] - a[0]*a[5]) * inv,
+		(a[3]*a[7] - a[4]*a[6]) * inv,
+		(a[1]*a[6] - a[0]*a[7]) * inv,
+		(a[0]*a[4] - a[1]*a[3]) * inv,
+	}
+	return z, nil
+}
+
+// FromAngleX sets z to the matrix rotating a Cart about the X axis by
+// theta, returns z.
+func (z *M3) FromAngleX(theta unit.Angle) *M3 {
+	s, c := theta.Sincos()
+	*z = M3{
+		1, 0, 0,
+		0, c, -s,
+		0, s, c,
+	}
+	return z
+}
+
+// FromAngleY sets z to the matrix rotating a Cart about the Y axis by
+// theta, returns z.
+func (z *M3) FromAngleY(theta unit.Angle) *M3 {
+	s, c := theta.Sincos()
+	*z = M3{
+		c, 0, s,
+		0, 1, 0,
+		-s, 0, c,
+	}
+	return z
+}
+
+// FromAngleZ sets z to the matrix rotating a Cart about the Z axis by
+// theta, returns z.
+func (z *M3) FromAngleZ(theta unit.Angle) *M3 {
+	s, c := theta.Sincos()
+	*z = M3{
+		c, -s, 0,
+		s, c, 0,
+		0, 0, 1,
+	}
+	return z
+}
+
+// FromEuler sets z to the composed rotation matrix for the given Euler
+// angles, returns z.
+//
+// order selects the axis sequence the angles are applied in; "ZYX" is
+// the common yaw/pitch/roll order and "ZXZ" is the classical
+// astronomical order used for precession-style transforms.  Angles are
+// applied left to right, so for "ZYX" z = FromAngleZ(yaw) ×
+// FromAngleY(pitch) × FromAngleX(roll).  An unrecognized order sets z to
+// the identity matrix.
+func (z *M3) FromEuler(yaw, pitch, roll unit.Angle, order string) *M3 {
+	var m1, m2, m3 M3
+	switch order {
+	case "ZYX":
+		m1.FromAngleZ(yaw)
+		m2.FromAngleY(pitch)
+		m3.FromAngleX(roll)
+	case "ZXZ":
+		m1.FromAngleZ(yaw)
+		m2.FromAngleX(pitch)
+		m3.FromAngleZ(roll)
+	default:
+		*z = M3{1, 0, 0, 0, 1, 0, 0, 0, 1}
+		return z
+	}
+	var t M3
+	t.Mul(&m2, &m3)
+	z.Mul(&m1, &t)
+	return z
+}
@@ -35,7 +35,7 @@ func ExampleCart_Dot() {
 
 func ExampleCart_FromSphr() {
 	c := new(coord.Cart)
-	s := &coord.Sphr{Ra: 0, Dec: 30 * math.Pi / 180}
+	s := &coord.Sphr{Lon: 0, Lat: 30 * math.Pi / 180}
 	fmt.Printf("%+.3v\n", *c.FromSphr(s))
 	// Output:
 	// {X:0.866 Y:0 Z:0.5}
@@ -69,6 +69,22 @@ func ExampleCart_RotateX() {
 	// {X:0 Y:0.866 Z:-0.5}
 }
 
+func ExampleCart_RotateY() {
+	a := &coord.Cart{1, 0, 0}
+	s, c := math.Sincos(30 * math.Pi / 180)
+	fmt.Printf("%+.3v\n", *new(coord.Cart).RotateY(a, s, c))
+	// Output:
+	// {X:0.866 Y:0 Z:0.5}
+}
+
+func ExampleCart_RotateZ() {
+	a := &coord.Cart{1, 0, 0}
+	s, c := math.Sincos(30 * math.Pi / 180)
+	fmt.Printf("%+.3v\n", *new(coord.Cart).RotateZ(a, s, c))
+	// Output:
+	// {X:0.866 Y:-0.5 Z:0}
+}
+
 func ExampleCart_Square() {
 	a := &coord.Cart{1, 2, 3}
 	fmt.Println(a.Square())
@@ -87,8 +103,8 @@ func ExampleCart_Sub() {
 func ExampleCartS_FromSphrS() {
 	s := coord.SphrS{
 		{},
-		{Ra: 30 * math.Pi / 180},
-		{Dec: 30 * math.Pi / 180},
+		{Lon: 30 * math.Pi / 180},
+		{Lat: 30 * math.Pi / 180},
 	}
 	for _, c := range new(coord.CartS).FromSphrS(s) {
 		fmt.Printf("%+.3v\n", c)
@@ -119,6 +135,77 @@ func ExampleCartS_Mult3S() {
 	// {X:0 Y:-0.5 Z:0.866}
 }
 
+func ExampleM3_Det() {
+	m := &coord.M3{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 3}
+	fmt.Println(m.Det())
+	// Output:
+	// 6
+}
+
+func ExampleM3_FromAngleX() {
+	m := new(coord.M3).FromAngleX(30 * math.Pi / 180)
+	a := &coord.Cart{0, 1, 0}
+	fmt.Printf("%+.3v\n", *new(coord.Cart).Mult3(m, a))
+	// Output:
+	// {X:0 Y:0.866 Z:0.5}
+}
+
+func ExampleM3_FromAngleY() {
+	m := new(coord.M3).FromAngleY(30 * math.Pi / 180)
+	a := &coord.Cart{0, 0, 1}
+	fmt.Printf("%+.3v\n", *new(coord.Cart).Mult3(m, a))
+	// Output:
+	// {X:0.5 Y:0 Z:0.866}
+}
+
+func ExampleM3_FromAngleZ() {
+	m := new(coord.M3).FromAngleZ(30 * math.Pi / 180)
+	a := &coord.Cart{1, 0, 0}
+	fmt.Printf("%+.3v\n", *new(coord.Cart).Mult3(m, a))
+	// Output:
+	// {X:0.866 Y:0.5 Z:0}
+}
+
+func ExampleM3_FromEuler() {
+	m := new(coord.M3).FromEuler(40*math.Pi/180, 0, 0, "ZYX")
+	a := &coord.Cart{1, 0, 0}
+	z := new(coord.Cart).Mult3(m, a)
+	fmt.Printf("%.3f %.3f %.3f\n", z.X, z.Y, z.Z)
+	// Output:
+	// 0.766 0.643 0.000
+}
+
+func ExampleM3_Inverse() {
+	m := &coord.M3{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 4}
+	inv, err := new(coord.M3).Inverse(m)
+	fmt.Println(inv[:3])
+	fmt.Println(inv[3:6])
+	fmt.Println(inv[6:])
+	fmt.Println(err)
+	// Output:
+	// [1 0 0]
+	// [0 0.5 0]
+	// [0 0 0.25]
+	// <nil>
+}
+
+func ExampleM3_Mul() {
+	rz1 := new(coord.M3).FromAngleZ(20 * math.Pi / 180)
+	rz2 := new(coord.M3).FromAngleZ(20 * math.Pi / 180)
+	m := new(coord.M3).Mul(rz1, rz2)
+	a := &coord.Cart{1, 0, 0}
+	z := new(coord.Cart).Mult3(m, a)
+	fmt.Printf("%.3f %.3f %.3f\n", z.X, z.Y, z.Z)
+	// Output:
+	// 0.766 0.643 0.000
+}
+
 func ExampleM3_Transpose() {
 	m := &coord.M3{
 		1, 2, 3,
@@ -137,8 +224,8 @@ func ExampleM3_Transpose() {
 func ExampleSphr_FromCart() {
 	c := &coord.Cart{X: math.Sqrt(3) / 2, Z: 1. / 2}
 	s := new(coord.Sphr).FromCart(c)
-	fmt.Printf("RA:  %3.0f\n", s.Ra*180/math.Pi)
-	fmt.Printf("Dec: %3.0f\n", s.Dec*180/math.Pi)
+	fmt.Printf("RA:  %3.0f\n", s.Lon*180/math.Pi)
+	fmt.Printf("Dec: %3.0f\n", s.Lat*180/math.Pi)
 	// Output:
 	// RA:    0
 	// Dec:  30
@@ -152,7 +239,7 @@ func ExampleSphrS_FromCartS() {
 	}
 	for _, s := range new(coord.SphrS).FromCartS(c) {
 		fmt.Printf("RA %3.0f, Dec %3.0f\n",
-			s.Ra*180/math.Pi, s.Dec*180/math.Pi)
+			s.Lon*180/math.Pi, s.Lat*180/math.Pi)
 	}
 	// Output:
 	// RA   0, Dec   0
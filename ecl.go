@@ -0,0 +1,118 @@
+// Public domain.
+
+package coord
+
+import (
+	"math"
+
+	"github.com/soniakeys/unit"
+)
+
+// Ecl represents ecliptic coordinates, referenced to the plane of
+// Earth's orbit.
+type Ecl struct {
+	Lon unit.Angle
+	Lat unit.Angle
+}
+
+// EclS is a slice of Ecl.
+type EclS []Ecl
+
+// FromEqua sets z to the ecliptic coordinates of e for the given
+// obliquity, returns z.
+//
+// The conversion is done by constructing an X-axis rotation matrix from
+// obliquity and routing e through Cart.
+func (z *Ecl) FromEqua(e *Equa, obliquity unit.Angle) *Ecl {
+	var s Sphr
+	s.Lon, s.Lat = e.RA.Angle(), e.Dec
+	var m M3
+	m.FromAngleX(-obliquity)
+	var v, r Cart
+	v.FromSphr(&s)
+	r.Mult3(&m, &v)
+	s.FromCart(&r)
+	z.Lon, z.Lat = s.Lon, s.Lat
+	return z
+}
+
+// FromEcl sets z to the equatorial coordinates of e for the given
+// obliquity, returns z.
+//
+// The conversion is done by constructing an X-axis rotation matrix from
+// obliquity and routing e through Cart.
+func (z *Equa) FromEcl(e *Ecl, obliquity unit.Angle) *Equa {
+	s := Sphr{Lon: e.Lon, Lat: e.Lat}
+	var m M3
+	m.FromAngleX(obliquity)
+	var v, r Cart
+	v.FromSphr(&s)
+	r.Mult3(&m, &v)
+	s.FromCart(&r)
+	z.RA = unit.RAFromRad(float64(s.Lon))
+	z.Dec = s.Lat
+	return z
+}
+
+// MeanObliquity returns the mean obliquity of the ecliptic at the given
+// Julian day, using the IAU 2006 polynomial in T, the number of Julian
+// centuries since J2000.0.
+func MeanObliquity(jd float64) unit.Angle {
+	t := (jd - 2451545) / 36525
+	sec := 84381.406 + t*(-46.836769+t*(-0.0001831+t*(0.00200340+
+		t*(-0.000000576+t*-0.0000000434))))
+	return unit.Angle(sec * math.Pi / (180 * 3600))
+}
+
+// FromEquaS converts equatorial slice e to ecliptic slice for the given
+// obliquity.  Receiver length is adjusted to the length of e.  The
+// rotation matrix is built once and reused across the slice.  The
+// receiver is returned.
+func (zp *EclS) FromEquaS(e EquaS, obliquity unit.Angle) EclS {
+	z := *zp
+	if cap(z) < len(e) {
+		z = make(EclS, len(e))
+	} else {
+		z = z[:len(e)]
+	}
+	var m M3
+	m.FromAngleX(-obliquity)
+	var s Sphr
+	var v, r Cart
+	for i := range e {
+		s.Lon, s.Lat = e[i].RA.Angle(), e[i].Dec
+		v.FromSphr(&s)
+		r.Mult3(&m, &v)
+		s.FromCart(&r)
+		z[i].Lon, z[i].Lat = s.Lon, s.Lat
+	}
+	*zp = z
+	return z
+}
+
+// FromEclS converts ecliptic slice e to equatorial slice for the given
+// obliquity.  Receiver length is adjusted to the length of e.  The
+// rotation matrix is built once and reused across the slice.  The
+// receiver is returned.
+func (zp *EquaS) FromEclS(e EclS, obliquity unit.Angle) EquaS {
+	z := *zp
+	if cap(z) < len(e) {
+		z = make(EquaS, len(e))
+	} else {
+		z = z[:len(e)]
+	}
+	var m M3
+	m.FromAngleX(obliquity)
+	var s Sphr
+	var v, r Cart
+	for i := range e {
+		s.Lon, s.Lat = e[i].Lon, e[i].Lat
+		v.FromSphr(&s)
+		r.Mult3(&m, &v)
+		s.FromCart(&r)
+		z[i].RA = unit.RAFromRad(float64(s.Lon))
+		z[i].Dec = s.Lat
+	}
+	*zp = z
+	return z
+}
@@ -0,0 +1,64 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/soniakeys/coord"
+)
+
+func ExampleEcl_FromEqua() {
+	e := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	c := new(coord.Ecl).FromEqua(e, 30*math.Pi/180)
+	fmt.Printf("Lon: %3.0f\n", float64(c.Lon)*180/math.Pi)
+	fmt.Printf("Lat: %3.0f\n", float64(c.Lat)*180/math.Pi)
+	// Output:
+	// Lon:  90
+	// Lat: -30
+}
+
+func ExampleEqua_FromEcl() {
+	e := &coord.Ecl{Lon: 90 * math.Pi / 180, Lat: -30 * math.Pi / 180}
+	c := new(coord.Equa).FromEcl(e, 30*math.Pi/180)
+	fmt.Printf("RA:  %3.0f\n", float64(c.RA.Angle())*180/math.Pi)
+	fmt.Printf("Dec: %3.0f\n", float64(c.Dec)*180/math.Pi)
+	// Output:
+	// RA:   90
+	// Dec:   0
+}
+
+func ExampleMeanObliquity() {
+	fmt.Printf("%.3f\n", float64(coord.MeanObliquity(2451545))*180/math.Pi)
+	// Output:
+	// 23.439
+}
+
+func ExampleEclS_FromEquaS() {
+	e := coord.EquaS{
+		{RA: 0, Dec: 0},
+		{RA: 90 * math.Pi / 180, Dec: 0},
+	}
+	for _, c := range new(coord.EclS).FromEquaS(e, 30*math.Pi/180) {
+		fmt.Printf("Lon %3.0f, Lat %3.0f\n",
+			float64(c.Lon)*180/math.Pi, float64(c.Lat)*180/math.Pi)
+	}
+	// Output:
+	// Lon   0, Lat   0
+	// Lon  90, Lat -30
+}
+
+func ExampleEquaS_FromEclS() {
+	e := coord.EclS{
+		{Lon: 0, Lat: 0},
+		{Lon: 90 * math.Pi / 180, Lat: -30 * math.Pi / 180},
+	}
+	for _, c := range new(coord.EquaS).FromEclS(e, 30*math.Pi/180) {
+		fmt.Printf("RA %3.0f, Dec %3.0f\n",
+			float64(c.RA.Angle())*180/math.Pi, float64(c.Dec)*180/math.Pi)
+	}
+	// Output:
+	// RA   0, Dec   0
+	// RA  90, Dec   0
+}
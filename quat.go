@@ -0,0 +1,187 @@
+// Public domain.
+
+package coord
+
+import (
+	"math"
+
+	"github.com/soniakeys/unit"
+)
+
+// Quat represents a rotation as a unit quaternion, W + Xi + Yj + Zk.
+//
+// Unlike chained M3 multiplications, composing rotations through Quat.Mul
+// and interpolating through Quat.Slerp do not accumulate the numerical
+// drift that repeated matrix products do, which matters for long chains
+// of frame transforms.
+type Quat struct {
+	W, X, Y, Z float64
+}
+
+// QuatS is a slice of Quat, paralleling CartS.
+type QuatS []Quat
+
+// Mul computes the Hamilton product.  It sets z = a × b, returns z.
+func (z *Quat) Mul(a, b *Quat) *Quat {
+	z.W, z.X, z.Y, z.Z =
+		a.W*b.W-a.X*b.X-a.Y*b.Y-a.Z*b.Z,
+		a.W*b.X+a.X*b.W+a.Y*b.Z-a.Z*b.Y,
+		a.W*b.Y-a.X*b.Z+a.Y*b.W+a.Z*b.X,
+		a.W*b.Z+a.X*b.Y-a.Y*b.X+a.Z*b.W
+	return z
+}
+
+// Conj sets z = conjugate of a, returns z.
+//
+// For a unit quaternion the conjugate is also the inverse.
+func (z *Quat) Conj(a *Quat) *Quat {
+	z.W, z.X, z.Y, z.Z = a.W, -a.X, -a.Y, -a.Z
+	return z
+}
+
+// Norm returns the magnitude of q.
+func (q *Quat) Norm() float64 {
+	return math.Sqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+}
+
+// Rotate applies q·c·q⁻¹, rotating c by the receiver.  q is assumed
+// to be a unit quaternion, as returned by FromAxisAngle or Slerp.
+func (q *Quat) Rotate(c *Cart) *Cart {
+	p := Quat{0, c.X, c.Y, c.Z}
+	var qc, r Quat
+	qc.Conj(q)
+	r.Mul(q, &p)
+	r.Mul(&r, &qc)
+	return &Cart{r.X, r.Y, r.Z}
+}
+
+// RotateS broadcasts Rotate to a slice, returning a newly allocated CartS.
+func (q *Quat) RotateS(c CartS) CartS {
+	r := make(CartS, len(c))
+	for i := range c {
+		r[i] = *q.Rotate(&c[i])
+	}
+	return r
+}
+
+// FromAxisAngle sets z to the rotation by theta about axis, which need
+// not be normalized.  It returns z.
+func (z *Quat) FromAxisAngle(axis *Cart, theta unit.Angle) *Quat {
+	sh, ch := (theta / 2).Sincos()
+	n := math.Sqrt(axis.Square())
+	if n == 0 {
+		z.W, z.X, z.Y, z.Z = 1, 0, 0, 0
+		return z
+	}
+	sh /= n
+	z.W, z.X, z.Y, z.Z = ch, axis.X*sh, axis.Y*sh, axis.Z*sh
+	return z
+}
+
+// AxisAngle extracts the axis and angle of rotation represented by q.
+//
+// If q is numerically close to the identity rotation (W near 1 or -1),
+// AxisAngle returns the X axis as an arbitrary axis together with the
+// angle 2·acos(W), which is near zero for W near 1 and near 2π for W
+// near -1.
+func (q *Quat) AxisAngle() (*Cart, unit.Angle) {
+	w := q.W
+	switch {
+	case w > 1:
+		w = 1
+	case w < -1:
+		w = -1
+	}
+	theta := unit.Angle(2 * math.Acos(w))
+	sinSq := 1 - w*w
+	if sinSq < 1e-12 {
+		return &Cart{1, 0, 0}, theta
+	}
+	s := math.Sqrt(sinSq)
+	return &Cart{q.X / s, q.Y / s, q.Z / s}, theta
+}
+
+// FromM3 sets z to the rotation represented by m, returns z.
+//
+// m is assumed to be a proper rotation matrix.  The standard trace-based
+// branch on the largest diagonal element is used to avoid the sqrt of a
+// negative number that a naive implementation risks.
+func (z *Quat) FromM3(m *M3) *Quat {
+	tr := m[0] + m[4] + m[8]
+	switch {
+	case tr > 0:
+		s := math.Sqrt(tr+1) * 2
+		z.W = 0.25 * s
+		z.X = (m[7] - m[5]) / s
+		z.Y = (m[2] - m[6]) / s
+		z.Z = (m[3] - m[1]) / s
+	case m[0] > m[4] && m[0] > m[8]:
+		s := math.Sqrt(1+m[0]-m[4]-m[8]) * 2
+		z.W = (m[7] - m[5]) / s
+		z.X = 0.25 * s
+		z.Y = (m[1] + m[3]) / s
+		z.Z = (m[2] + m[6]) / s
+	case m[4] > m[8]:
+		s := math.Sqrt(1+m[4]-m[0]-m[8]) * 2
+		z.W = (m[2] - m[6]) / s
+		z.X = (m[1] + m[3]) / s
+		z.Y = 0.25 * s
+		z.Z = (m[5] + m[7]) / s
+	default:
+		s := math.Sqrt(1+m[8]-m[0]-m[4]) * 2
+		z.W = (m[3] - m[1]) / s
+		z.X = (m[2] + m[6]) / s
+		z.Y = (m[5] + m[7]) / s
+		z.Z = 0.25 * s
+	}
+	return z
+}
+
+// ToM3 sets z to the rotation matrix represented by q, returns z.
+func (q *Quat) ToM3(z *M3) *M3 {
+	w, x, y, zz := q.W, q.X, q.Y, q.Z
+	z[0] = 1 - 2*(y*y+zz*zz)
+	z[1] = 2 * (x*y - w*zz)
+	z[2] = 2 * (x*zz + w*y)
+	z[3] = 2 * (x*y + w*zz)
+	z[4] = 1 - 2*(x*x+zz*zz)
+	z[5] = 2 * (y*zz - w*x)
+	z[6] = 2 * (x*zz - w*y)
+	z[7] = 2 * (y*zz + w*x)
+	z[8] = 1 - 2*(x*x+y*y)
+	return z
+}
+
+// Slerp sets z to the spherical linear interpolation of a and b at t,
+// where t = 0 gives a and t = 1 gives b.  It returns z.
+//
+// When a and b are nearly parallel, Slerp falls back to a normalized
+// linear interpolation to avoid dividing by a near-zero sine.  b is
+// negated when it points into the opposite hemisphere from a, so that
+// the interpolation always takes the short arc.
+func (z *Quat) Slerp(a, b *Quat, t float64) *Quat {
+	dot := a.W*b.W + a.X*b.X + a.Y*b.Y + a.Z*b.Z
+	bw, bx, by, bz := b.W, b.X, b.Y, b.Z
+	if dot < 0 {
+		dot, bw, bx, by, bz = -dot, -bw, -bx, -by, -bz
+	}
+	if dot > 0.9995 {
+		z.W = a.W + t*(bw-a.W)
+		z.X = a.X + t*(bx-a.X)
+		z.Y = a.Y + t*(by-a.Y)
+		z.Z = a.Z + t*(bz-a.Z)
+		n := z.Norm()
+		z.W, z.X, z.Y, z.Z = z.W/n, z.X/n, z.Y/n, z.Z/n
+		return z
+	}
+	theta0 := math.Acos(dot)
+	theta := theta0 * t
+	sinTheta0 := math.Sin(theta0)
+	s0 := math.Cos(theta) - dot*math.Sin(theta)/sinTheta0
+	s1 := math.Sin(theta) / sinTheta0
+	z.W = s0*a.W + s1*bw
+	z.X = s0*a.X + s1*bx
+	z.Y = s0*a.Y + s1*by
+	z.Z = s0*a.Z + s1*bz
+	return z
+}
@@ -0,0 +1,73 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/soniakeys/coord"
+)
+
+func ExampleQuat_FromAxisAngle() {
+	axis := &coord.Cart{0, 0, 1}
+	q := new(coord.Quat).FromAxisAngle(axis, 90*math.Pi/180)
+	fmt.Printf("%+.3v\n", *q)
+	// Output:
+	// {W:0.707 X:0 Y:0 Z:0.707}
+}
+
+func ExampleQuat_Rotate() {
+	axis := &coord.Cart{0, 0, 1}
+	q := new(coord.Quat).FromAxisAngle(axis, 40*math.Pi/180)
+	c := q.Rotate(&coord.Cart{1, 0, 0})
+	fmt.Printf("%.3f %.3f %.3f\n", c.X, c.Y, c.Z)
+	// Output:
+	// 0.766 0.643 0.000
+}
+
+func ExampleQuat_AxisAngle() {
+	axis := &coord.Cart{0, 0, 1}
+	q := new(coord.Quat).FromAxisAngle(axis, 90*math.Pi/180)
+	a, theta := q.AxisAngle()
+	fmt.Printf("%+.3v\n", *a)
+	fmt.Printf("%.3f\n", float64(theta))
+	// Output:
+	// {X:0 Y:0 Z:1}
+	// 1.571
+}
+
+func ExampleQuat_FromM3() {
+	m := &coord.M3{
+		0, -1, 0,
+		1, 0, 0,
+		0, 0, 1}
+	q := new(coord.Quat).FromM3(m)
+	fmt.Printf("%+.3v\n", *q)
+	// Output:
+	// {W:0.707 X:0 Y:0 Z:0.707}
+}
+
+func ExampleQuat_ToM3() {
+	axis := &coord.Cart{0, 0, 1}
+	q := new(coord.Quat).FromAxisAngle(axis, 40*math.Pi/180)
+	var m coord.M3
+	q.ToM3(&m)
+	fmt.Printf("%.3f %.3f %.3f\n", m[0], m[1], m[2])
+	fmt.Printf("%.3f %.3f %.3f\n", m[3], m[4], m[5])
+	fmt.Printf("%.3f %.3f %.3f\n", m[6], m[7], m[8])
+	// Output:
+	// 0.766 -0.643 0.000
+	// 0.643 0.766 0.000
+	// 0.000 0.000 1.000
+}
+
+func ExampleQuat_Slerp() {
+	a := new(coord.Quat).FromAxisAngle(&coord.Cart{0, 0, 1}, 0)
+	b := new(coord.Quat).FromAxisAngle(&coord.Cart{0, 0, 1}, 90*math.Pi/180)
+	var z coord.Quat
+	z.Slerp(a, b, 0.5)
+	fmt.Printf("%+.3v\n", z)
+	// Output:
+	// {W:0.924 X:0 Y:0 Z:0.383}
+}
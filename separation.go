@@ -0,0 +1,72 @@
+// Public domain.
+
+package coord
+
+import (
+	"math"
+
+	"github.com/soniakeys/unit"
+)
+
+// Separation returns the angular distance between a and b using the
+// Vincenty formula, which unlike the naive acos(sin·sin+cos·cos·cos)
+// form stays numerically stable for angles near 0 and π.
+func (a *Equa) Separation(b *Equa) unit.Angle {
+	sd1, cd1 := a.Dec.Sincos()
+	sd2, cd2 := b.Dec.Sincos()
+	sdra, cdra := (b.RA.Angle() - a.RA.Angle()).Sincos()
+	y := math.Hypot(cd2*sdra, cd1*sd2-sd1*cd2*cdra)
+	x := sd1*sd2 + cd1*cd2*cdra
+	return unit.Angle(math.Atan2(y, x))
+}
+
+// Separation returns the angular distance between a and b using the
+// Vincenty formula, which unlike the naive acos(sin·sin+cos·cos·cos)
+// form stays numerically stable for angles near 0 and π.
+func (a *Sphr) Separation(b *Sphr) unit.Angle {
+	sd1, cd1 := a.Lat.Sincos()
+	sd2, cd2 := b.Lat.Sincos()
+	sdlon, cdlon := (b.Lon - a.Lon).Sincos()
+	y := math.Hypot(cd2*sdlon, cd1*sd2-sd1*cd2*cdlon)
+	x := sd1*sd2 + cd1*cd2*cdlon
+	return unit.Angle(math.Atan2(y, x))
+}
+
+// PositionAngle returns the bearing from a to b, measured east from
+// north.
+func (a *Equa) PositionAngle(b *Equa) unit.Angle {
+	sd1, cd1 := a.Dec.Sincos()
+	sd2, cd2 := b.Dec.Sincos()
+	sdra, cdra := (b.RA.Angle() - a.RA.Angle()).Sincos()
+	return unit.Angle(math.Atan2(sdra*cd2, cd1*sd2-sd1*cd2*cdra))
+}
+
+// Offset returns the point reached by moving from a in direction pa
+// (position angle, measured east from north) by angular distance sep.
+func (a *Equa) Offset(pa, sep unit.Angle) *Equa {
+	sd1, cd1 := a.Dec.Sincos()
+	ss, cs := sep.Sincos()
+	spa, cpa := pa.Sincos()
+	sd2 := sd1*cs + cd1*ss*cpa
+	dec2 := unit.Angle(math.Asin(sd2))
+	dra := math.Atan2(spa*ss*cd1, cs-sd1*sd2)
+	return &Equa{
+		RA:  unit.RAFromRad(float64(a.RA.Angle()) + dra),
+		Dec: dec2,
+	}
+}
+
+// Centroid sums the unit vectors of c and renormalizes, the standard
+// robust way to average directions on a sphere.  It returns a newly
+// allocated Cart, or the zero vector if c is empty or the vectors
+// exactly cancel.
+func (c CartS) Centroid() *Cart {
+	var sum Cart
+	for i := range c {
+		sum.Add(&sum, &c[i])
+	}
+	if n := math.Sqrt(sum.Square()); n != 0 {
+		sum.MulScalar(&sum, 1/n)
+	}
+	return &sum
+}
@@ -0,0 +1,54 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/soniakeys/coord"
+)
+
+func ExampleEqua_Separation() {
+	a := &coord.Equa{RA: 0, Dec: 0}
+	b := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	fmt.Printf("%.3f\n", float64(a.Separation(b)))
+	// Output:
+	// 1.571
+}
+
+func ExampleSphr_Separation() {
+	a := &coord.Sphr{Lon: 0, Lat: 0}
+	b := &coord.Sphr{Lon: 90 * math.Pi / 180, Lat: 0}
+	fmt.Printf("%.3f\n", float64(a.Separation(b)))
+	// Output:
+	// 1.571
+}
+
+func ExampleEqua_PositionAngle() {
+	a := &coord.Equa{RA: 0, Dec: 0}
+	b := &coord.Equa{RA: 90 * math.Pi / 180, Dec: 0}
+	fmt.Printf("%.3f\n", float64(a.PositionAngle(b)))
+	// Output:
+	// 1.571
+}
+
+func ExampleEqua_Offset() {
+	a := &coord.Equa{RA: 0, Dec: 0}
+	b := a.Offset(90*math.Pi/180, 90*math.Pi/180)
+	fmt.Printf("RA:  %.3f\n", float64(b.RA.Angle()))
+	fmt.Printf("Dec: %.3f\n", float64(b.Dec))
+	// Output:
+	// RA:  1.571
+	// Dec: 0.000
+}
+
+func ExampleCartS_Centroid() {
+	c := coord.CartS{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+	fmt.Printf("%+.3v\n", *c.Centroid())
+	// Output:
+	// {X:0.707 Y:0.707 Z:0}
+}
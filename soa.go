@@ -0,0 +1,87 @@
+// Public domain.
+
+package coord
+
+// CartSoA holds the same data as a CartS but in struct-of-arrays form,
+// where each coordinate is a tight, contiguous slice.  This layout lets
+// M3.ApplySoA run a single tight loop over the three coordinate slices,
+// computing all three output coordinates of each point in one pass,
+// rather than the per-element Cart.Mult3 calls of CartS.Mult3S.
+type CartSoA struct {
+	X, Y, Z []float64
+}
+
+// FromAoS converts c to struct-of-arrays form, storing the result in s.
+// Receiver capacity is reused if sufficient.  It returns s.
+func (s *CartSoA) FromAoS(c CartS) *CartSoA {
+	if cap(s.X) < len(c) {
+		s.X = make([]float64, len(c))
+		s.Y = make([]float64, len(c))
+		s.Z = make([]float64, len(c))
+	} else {
+		s.X = s.X[:len(c)]
+		s.Y = s.Y[:len(c)]
+		s.Z = s.Z[:len(c)]
+	}
+	for i, c1 := range c {
+		s.X[i], s.Y[i], s.Z[i] = c1.X, c1.Y, c1.Z
+	}
+	return s
+}
+
+// ToAoS converts s back to array-of-structs form.  The capacity of cp is
+// reused if sufficient.  It returns the resulting CartS.
+func (s *CartSoA) ToAoS(cp CartS) CartS {
+	c := cp
+	if cap(c) < len(s.X) {
+		c = make(CartS, len(s.X))
+	} else {
+		c = c[:len(s.X)]
+	}
+	for i := range s.X {
+		c[i] = Cart{s.X[i], s.Y[i], s.Z[i]}
+	}
+	return c
+}
+
+// ApplySoA sets dst = m × src, broadcasting the matrix-vector product
+// over every point in src.  Capacity of dst is reused if sufficient.
+//
+// This is an additive fast path alongside CartS.Mult3S for hot loops
+// such as ecliptic→equatorial→observer-frame chains applied to large
+// slices of points.
+func (m *M3) ApplySoA(dst, src *CartSoA) {
+	n := len(src.X)
+	if cap(dst.X) < n {
+		dst.X = make([]float64, n)
+		dst.Y = make([]float64, n)
+		dst.Z = make([]float64, n)
+	} else {
+		dst.X = dst.X[:n]
+		dst.Y = dst.Y[:n]
+		dst.Z = dst.Z[:n]
+	}
+	m0, m1, m2 := m[0], m[1], m[2]
+	m3, m4, m5 := m[3], m[4], m[5]
+	m6, m7, m8 := m[6], m[7], m[8]
+	sx, sy, sz := src.X, src.Y, src.Z
+	dx, dy, dz := dst.X, dst.Y, dst.Z
+	for i := 0; i < n; i++ {
+		x, y, z := sx[i], sy[i], sz[i]
+		dx[i] = m0*x + m1*y + m2*z
+		dy[i] = m3*x + m4*y + m5*z
+		dz[i] = m6*x + m7*y + m8*z
+	}
+}
+
+// Compose left-folds a chain of rotations into a single matrix, so that
+// callers can collapse a sequence such as precession, nutation, frame
+// bias, and diurnal rotation into one multiply.  It sets
+// z = ms[0] × ms[1] × ... × ms[len(ms)-1] and returns z.
+func (z *M3) Compose(ms ...*M3) *M3 {
+	*z = M3{1, 0, 0, 0, 1, 0, 0, 0, 1}
+	for _, m := range ms {
+		z.Mul(z, m)
+	}
+	return z
+}
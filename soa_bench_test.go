@@ -0,0 +1,62 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soniakeys/coord"
+)
+
+var benchSizes = []int{1e3, 1e4, 1e5, 1e6}
+
+func benchPoints(n int) coord.CartS {
+	c := make(coord.CartS, n)
+	for i := range c {
+		c[i] = coord.Cart{X: 1, Y: float64(i), Z: -float64(i)}
+	}
+	return c
+}
+
+// BenchmarkMult3S times the existing array-of-structs broadcast.
+func BenchmarkMult3S(b *testing.B) {
+	m := new(coord.M3).FromAngleZ(30 * math.Pi / 180)
+	for _, n := range benchSizes {
+		a := benchPoints(n)
+		var z coord.CartS
+		b.Run(sizeLabel(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				z.Mult3S(m, a)
+			}
+		})
+	}
+}
+
+// BenchmarkApplySoA times the struct-of-arrays fast path over the same
+// sizes, demonstrating the win from vectorizable loops.
+func BenchmarkApplySoA(b *testing.B) {
+	m := new(coord.M3).FromAngleZ(30 * math.Pi / 180)
+	for _, n := range benchSizes {
+		var src, dst coord.CartSoA
+		src.FromAoS(benchPoints(n))
+		b.Run(sizeLabel(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m.ApplySoA(&dst, &src)
+			}
+		})
+	}
+}
+
+func sizeLabel(n int) string {
+	switch n {
+	case 1e3:
+		return "1e3"
+	case 1e4:
+		return "1e4"
+	case 1e5:
+		return "1e5"
+	default:
+		return "1e6"
+	}
+}
@@ -0,0 +1,68 @@
+// Public domain.
+
+package coord_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/soniakeys/coord"
+)
+
+func ExampleCartSoA_FromAoS() {
+	c := coord.CartS{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+	var s coord.CartSoA
+	s.FromAoS(c)
+	fmt.Println(s.X)
+	fmt.Println(s.Y)
+	fmt.Println(s.Z)
+	// Output:
+	// [1 0]
+	// [0 1]
+	// [0 0]
+}
+
+func ExampleCartSoA_ToAoS() {
+	s := &coord.CartSoA{
+		X: []float64{1, 0},
+		Y: []float64{0, 1},
+		Z: []float64{0, 0},
+	}
+	for _, c := range s.ToAoS(nil) {
+		fmt.Printf("%+v\n", c)
+	}
+	// Output:
+	// {X:1 Y:0 Z:0}
+	// {X:0 Y:1 Z:0}
+}
+
+func ExampleM3_ApplySoA() {
+	m := new(coord.M3).FromAngleZ(40 * math.Pi / 180)
+	src := &coord.CartSoA{
+		X: []float64{1, 0},
+		Y: []float64{0, 1},
+		Z: []float64{0, 0},
+	}
+	var dst coord.CartSoA
+	m.ApplySoA(&dst, src)
+	for i := range dst.X {
+		fmt.Printf("%.3f %.3f %.3f\n", dst.X[i], dst.Y[i], dst.Z[i])
+	}
+	// Output:
+	// 0.766 0.643 0.000
+	// -0.643 0.766 0.000
+}
+
+func ExampleM3_Compose() {
+	rz := new(coord.M3).FromAngleZ(40 * math.Pi / 180)
+	ry := new(coord.M3).FromAngleY(0)
+	m := new(coord.M3).Compose(rz, ry)
+	a := &coord.Cart{1, 0, 0}
+	z := new(coord.Cart).Mult3(m, a)
+	fmt.Printf("%.3f %.3f %.3f\n", z.X, z.Y, z.Z)
+	// Output:
+	// 0.766 0.643 0.000
+}